@@ -198,6 +198,7 @@ func printMixedOpAnalysis(ctx *cli.Context, aggr aggregate.Aggregated, details b
 	if aggr.MixedServerStats == nil {
 		console.Errorln("No mixed stats")
 	}
+	mix := requestedMix(ctx)
 	for _, ops := range aggr.Operations {
 		if details {
 			console.Println("\n----------------------------------------")
@@ -210,15 +211,19 @@ func printMixedOpAnalysis(ctx *cli.Context, aggr aggregate.Aggregated, details b
 			pct = 100.0 * float64(ops.Throughput.Operations) / float64(aggr.MixedServerStats.Operations)
 		}
 		duration := ops.EndTime.Sub(ops.StartTime).Truncate(time.Second)
+		requested := ""
+		if req, ok := mix[ops.Type]; ok {
+			requested = fmt.Sprintf(" (requested %.01f%%)", 100*req)
+		}
 
 		if !details || ops.Skipped {
-			console.Printf("Operation: %v, %d%%, Concurrency: %d, Ran %v.\n", ops.Type, int(pct+0.5), ops.Concurrency, duration)
+			console.Printf("Operation: %v, %d%%%s, Concurrency: %d, Ran %v.\n", ops.Type, int(pct+0.5), requested, ops.Concurrency, duration)
 		} else {
 			sz := ""
 			if ops.SingleSizedRequests != nil && ops.SingleSizedRequests.ObjSize > 0 {
 				sz = fmt.Sprintf("Size: %d bytes. ", ops.SingleSizedRequests.ObjSize)
 			}
-			console.Printf("Operation: %v - total: %v, %.01f%%, %vConcurrency: %d, Ran %v, starting %v\n", ops.Type, ops.Throughput.Operations, pct, sz, ops.Concurrency, duration, ops.StartTime.Truncate(time.Millisecond))
+			console.Printf("Operation: %v - total: %v, %.01f%%%s, %vConcurrency: %d, Ran %v, starting %v\n", ops.Type, ops.Throughput.Operations, pct, requested, sz, ops.Concurrency, duration, ops.StartTime.Truncate(time.Millisecond))
 		}
 		console.SetColor("Print", color.New(color.FgWhite))
 
@@ -230,6 +235,10 @@ func printMixedOpAnalysis(ctx *cli.Context, aggr aggregate.Aggregated, details b
 		if ops.Errors > 0 {
 			console.SetColor("Print", color.New(color.FgHiRed))
 			console.Println("Errors:", ops.Errors)
+			console.SetColor("Print", color.New(color.FgWhite))
+			for _, e := range ops.ErrorCounts.Top(3) {
+				console.Printf(" - %dx: %s\n", e.N, e.Err)
+			}
 			if details {
 				for _, err := range ops.FirstErrors {
 					console.Println(err)
@@ -237,6 +246,7 @@ func printMixedOpAnalysis(ctx *cli.Context, aggr aggregate.Aggregated, details b
 			}
 			console.SetColor("Print", color.New(color.FgWhite))
 		}
+		printInvalidDurations(ops)
 		eps := ops.ThroughputByHost
 		if len(eps) == 1 || !details {
 			console.Println(" * Throughput:", ops.Throughput.StringDetails(details))
@@ -318,7 +328,8 @@ func (rc readCloser) Close() error {
 	return rc.closeFn()
 }
 
-func printAnalysis(ctx *cli.Context, w io.Writer, o bench.Operations) {
+// printAnalysis prints the analysis of o to w and returns the aggregated data.
+func printAnalysis(ctx *cli.Context, w io.Writer, o bench.Operations) aggregate.Aggregated {
 	details := ctx.Bool("analyze.v")
 	var wrSegs io.Writer
 	prefiltered := false
@@ -341,7 +352,7 @@ func printAnalysis(ctx *cli.Context, w io.Writer, o bench.Operations) {
 			for _, h := range hosts {
 				console.Println("\t*", h)
 			}
-			return
+			return aggregate.Aggregated{}
 		}
 		prefiltered = true
 		o = o2
@@ -375,7 +386,7 @@ func printAnalysis(ctx *cli.Context, w io.Writer, o bench.Operations) {
 			console.Errorln(err)
 		}
 		os.Stdout.Write(b)
-		return
+		return aggr
 	}
 
 	preOutput := color.Output
@@ -386,7 +397,7 @@ func printAnalysis(ctx *cli.Context, w io.Writer, o bench.Operations) {
 
 	if aggr.Mixed {
 		printMixedOpAnalysis(ctx, aggr, details)
-		return
+		return aggr
 	}
 
 	for _, ops := range aggr.Operations {
@@ -423,8 +434,11 @@ func printAnalysis(ctx *cli.Context, w io.Writer, o bench.Operations) {
 		if ops.Errors > 0 {
 			console.SetColor("Print", color.New(color.FgHiRed))
 			console.Println("Errors:", ops.Errors)
+			console.SetColor("Print", color.New(color.FgWhite))
+			for _, e := range ops.ErrorCounts.Top(3) {
+				console.Printf(" - %dx: %s\n", e.N, e.Err)
+			}
 			if details {
-				console.SetColor("Print", color.New(color.FgWhite))
 				console.Println("First Errors:")
 				for _, err := range ops.FirstErrors {
 					console.Println(" *", err)
@@ -432,6 +446,7 @@ func printAnalysis(ctx *cli.Context, w io.Writer, o bench.Operations) {
 				console.Println("")
 			}
 		}
+		printInvalidDurations(ops)
 
 		if ops.Skipped {
 			console.SetColor("Print", color.New(color.FgHiWhite))
@@ -488,6 +503,7 @@ func printAnalysis(ctx *cli.Context, w io.Writer, o bench.Operations) {
 		console.Println(" * 50% Median:", aggregate.SegmentSmall{BPS: segs.MedianBPS, OPS: segs.MedianOPS, Start: segs.MedianStart}.StringLong(dur, details))
 		console.Println(" * Slowest:", aggregate.SegmentSmall{BPS: segs.SlowestBPS, OPS: segs.SlowestOPS, Start: segs.SlowestStart}.StringLong(dur, details))
 	}
+	return aggr
 }
 
 func writeSegs(ctx *cli.Context, wrSegs io.Writer, ops bench.Operations, allThreads, details bool) {
@@ -752,3 +768,13 @@ func getAnalyzeOPS(ctx *cli.Context) map[string]struct{} {
 	}
 	return nil
 }
+
+// printInvalidDurations warns about operations without a positive duration.
+func printInvalidDurations(ops aggregate.Operation) {
+	if ops.InvalidDurations == 0 {
+		return
+	}
+	console.SetColor("Print", color.New(color.FgHiYellow))
+	console.Printf("Warning: %d operations did not have a positive duration, likely caused by clock adjustments.\n", ops.InvalidDurations)
+	console.SetColor("Print", color.New(color.FgWhite))
+}
@@ -235,6 +235,9 @@ func serveWs(w http.ResponseWriter, r *http.Request) {
 			console.Infoln("Starting stage", req.Stage, "in", wait)
 			go func() {
 				time.Sleep(wait)
+				if req.Stage == stageBenchmark {
+					injectErrorsArmed.Store(true)
+				}
 				close(info.start)
 			}()
 			resp.Type = clientRespStatus
@@ -27,8 +27,10 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"slices"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -63,6 +65,10 @@ var benchFlags = []cli.Flag{
 		Name:  "autoterm",
 		Usage: "Auto terminate when benchmark is considered stable.",
 	},
+	cli.BoolFlag{
+		Name:  "autowarmup",
+		Usage: "Discard results until the 99th percentile request time is stable, then start measuring. Uses autoterm.dur and autoterm.pct. Warmup counts towards --duration.",
+	},
 	cli.DurationFlag{
 		Name:  "autoterm.dur",
 		Usage: "Minimum duration where output must have been stable to allow automatic termination.",
@@ -87,6 +93,28 @@ var benchFlags = []cli.Flag{
 		Usage: "Specify a benchmark start time. Time format is 'hh:mm' where hours are specified in 24h format, server TZ.",
 		Value: "",
 	},
+	cli.StringFlag{
+		Name:  "control-addr",
+		Usage: "Accept 'pause', 'resume' and 'status' commands on this address while benchmarking. Use 'unix:/path' for a Unix socket. Paused time counts towards --duration.",
+		Value: "",
+	},
+	cli.DurationFlag{
+		Name:  "idle-before-measure",
+		Usage: "Wait this long after preparing before starting the benchmark. Can be used to let server side caches expire.",
+	},
+	cli.BoolFlag{
+		Name:  "prepare-only",
+		Usage: "Only prepare the benchmark and exit. Prepared data is left in place and no benchmark is run.",
+	},
+	cli.DurationFlag{
+		Name:  "deadline",
+		Usage: "Stop the whole run, including prepare and cleanup, when this duration is exceeded. Results collected so far are reported.",
+	},
+	cli.StringFlag{
+		Name:  "slo",
+		Usage: "Fail the run with a non-zero exit code if objectives are not met. Example: 'get.p99<30ms,error_rate<0.001'. Metrics: avg, p50, p90, p99, error_rate.",
+		Value: "",
+	},
 	cli.StringFlag{
 		Name:   "warp-client",
 		Usage:  "Connect to warp clients and run benchmarks there.",
@@ -141,16 +169,25 @@ func runBench(ctx *cli.Context, b bench.Benchmark) error {
 	}, printError)
 	defer monitor.Done()
 
+	// runCtx bounds everything from prepare to cleanup.
+	runCtx := context.Background()
+	if d := ctx.Duration("deadline"); d > 0 {
+		var cancel context.CancelFunc
+		runCtx, cancel = context.WithTimeout(runCtx, d)
+		defer cancel()
+	}
+
 	monitor.InfoLn("Preparing server")
 	c.Clear = !ctx.Bool("noclear")
-	if ctx.Bool("autoterm") {
+	// With autowarmup, autoterm is started when measuring starts.
+	if ctx.Bool("autoterm") && !ctx.Bool("autowarmup") {
 		c.AutoTermDur = ctx.Duration("autoterm.dur")
 		c.AutoTermScale = ctx.Float64("autoterm.pct") / 100
 	}
 	c.PrepareProgress = make(chan float64, 1)
 	ui.StartPrepare("Preparing", c.PrepareProgress, updates)
 
-	err := b.Prepare(context.Background())
+	err := b.Prepare(runCtx)
 	if err != nil {
 		// Ensure UI is properly cleaned up before exiting with error
 		ui.Update(tea.Quit())
@@ -166,7 +203,7 @@ func runBench(ctx *cli.Context, b bench.Benchmark) error {
 	}
 
 	if ap, ok := b.(AfterPreparer); ok {
-		err := ap.AfterPrepare(context.Background())
+		err := ap.AfterPrepare(runCtx)
 		if err != nil {
 			// Ensure UI is properly cleaned up before exiting with error
 			ui.Update(tea.Quit())
@@ -175,6 +212,13 @@ func runBench(ctx *cli.Context, b bench.Benchmark) error {
 			return nil
 		}
 	}
+	if ctx.Bool("prepare-only") {
+		ui.Update(tea.Quit())
+		ui.Wait()
+		registerUI(nil)
+		console.Infoln("Prepare done. Skipping benchmark and cleanup.")
+		return nil
+	}
 	srv := wui.New(nil)
 	showAddress := ""
 	if ctx.Bool("web") {
@@ -189,7 +233,7 @@ func runBench(ctx *cli.Context, b bench.Benchmark) error {
 	}
 
 	// Start after waiting a second or until we reached the start time.
-	tStart := time.Now().Add(time.Second * 3)
+	tStart := time.Now().Add(time.Second*3 + ctx.Duration("idle-before-measure"))
 	if st := ctx.String("syncstart"); st != "" {
 		startTime := parseLocalTime(st)
 		now := time.Now()
@@ -205,7 +249,7 @@ func runBench(ctx *cli.Context, b bench.Benchmark) error {
 	}
 	benchDur := ctx.Duration("duration")
 	ui.StartBenchmark("Benchmarking", tStart, tStart.Add(benchDur), updates)
-	ctx2, cancel := context.WithDeadline(context.Background(), tStart.Add(benchDur))
+	ctx2, cancel := context.WithDeadline(runCtx, tStart.Add(benchDur))
 	defer cancel()
 	ui.cancelFn.Store(&cancel)
 	start := make(chan struct{})
@@ -213,6 +257,9 @@ func runBench(ctx *cli.Context, b bench.Benchmark) error {
 		monitor.InfoLn("Pausing before benchmark")
 		<-time.After(time.Until(tStart))
 		monitor.InfoLn("Press 'q' to stop benchmark. " + showAddress)
+		resetTLSHandshakes()
+		resetConnStats()
+		injectErrorsArmed.Store(true)
 		close(start)
 	}()
 
@@ -222,16 +269,49 @@ func runBench(ctx *cli.Context, b bench.Benchmark) error {
 		fileName = fmt.Sprintf("%s-%s-%s-%s", appName, ctx.Command.Name, time.Now().Format("2006-01-02[150405]"), cID)
 	}
 
+	if addr := ctx.String("control-addr"); addr != "" {
+		c.Pauser = &bench.Pauser{}
+		cs, err := startControlServer(addr, c.Pauser, updates, tStart)
+		fatalIf(probe.NewError(err), "Unable to start control server")
+		defer cs.Close()
+	}
+
+	var warmupDone atomic.Bool
+	if ctx.Bool("autowarmup") {
+		go func(benchCtx context.Context) {
+			<-start
+			dur, scale := ctx.Duration("autoterm.dur"), ctx.Float64("autoterm.pct")/100
+			msg := aggregate.WaitLatencyStable(benchCtx, "", scale, dur, updates)
+			if msg == "" || benchCtx.Err() != nil {
+				return
+			}
+			updates <- aggregate.UpdateReq{Reset: true}
+			warmupDone.Store(true)
+			resetTLSHandshakes()
+			resetConnStats()
+			monitor.InfoLn(msg + ". Warmup done, measuring")
+			if ctx.Bool("autoterm") {
+				termCtx := aggregate.AutoTerm(benchCtx, "", scale, int(dur.Seconds()+0.999), dur, updates)
+				<-termCtx.Done()
+				cancel()
+			}
+		}(ctx2)
+	}
+
 	prof, err := startProfiling(ctx2, ctx)
 	fatalIf(probe.NewError(err), "Unable to start profile.")
 	monitor.InfoLn("Starting benchmark in", time.Until(tStart).Round(time.Second))
 	b.Start(ctx2, start)
+	injectErrorsArmed.Store(false)
 	c.Collector.Close()
 	cancel()
 
 	ctx2 = context.Background()
 	prof.stop(ctx2, ctx, fileName+".profiles.zip")
 
+	// Objectives are checked when the report is printed, but only fail the run after cleanup.
+	var sloErr error
+
 	// Previous context is canceled, create a new...
 	monitor.InfoLn("Saving benchmark data")
 	if ops := retrieveOps(); len(ops) > 0 {
@@ -258,10 +338,11 @@ func runBench(ctx *cli.Context, b bench.Benchmark) error {
 		}
 		monitor.OperationsReady(ops, fileName, commandLine(ctx))
 		var buf bytes.Buffer
-		printAnalysis(ctx, &buf, ops)
+		aggr := printAnalysis(ctx, &buf, ops)
 		ui.Update(tea.Quit())
 		ui.Wait()
 		fmt.Println(buf.String())
+		sloErr = checkSLOs(ctx, aggr)
 	} else if updates != nil {
 		finalCh := make(chan *aggregate.Realtime, 1)
 		updates <- aggregate.UpdateReq{Final: true, C: finalCh}
@@ -270,6 +351,8 @@ func runBench(ctx *cli.Context, b bench.Benchmark) error {
 		final.WarpVersion = GlobalVersion
 		final.WarpDate = GlobalDate
 		final.WarpCommit = GlobalCommit
+		final.Tags = parseRunTags(ctx)
+		final.RequestedMix = requestedMix(ctx)
 		f, err := os.Create(fileName + ".json.zst")
 		if err != nil {
 			monitor.Errorln("Unable to write benchmark data:", err)
@@ -311,13 +394,33 @@ func runBench(ctx *cli.Context, b bench.Benchmark) error {
 		ui.Wait()
 		fmt.Println("")
 		fmt.Println(rep)
+		if ctx.Bool("autowarmup") && !warmupDone.Load() {
+			monitor.Errorln("Warmup did not complete before the benchmark ended. Results include the warmup.")
+		}
+		sloErr = checkSLOs(ctx, final)
 	}
-	if !ctx.Bool("keep-data") && !ctx.Bool("noclear") {
+	if ctx.Bool("conn-stats") && !globalJSON {
+		for _, stats := range []string{connStats(), tlsHandshakeStats()} {
+			if stats != "" {
+				fmt.Println(stats)
+			}
+		}
+	}
+	switch {
+	case ctx.Bool("keep-data") || ctx.Bool("noclear"):
+		monitor.InfoLn("Cleanup Done.")
+	case runCtx.Err() != nil:
+		monitor.Errorln("Deadline reached, skipping cleanup. Benchmark data is left on the server.")
+	default:
 		ui.SetPhase("Cleanup")
 		monitor.InfoLn("Starting cleanup...")
-		b.Cleanup(context.Background())
+		b.Cleanup(runCtx)
+		if runCtx.Err() != nil {
+			monitor.Errorln("Deadline reached during cleanup. Some benchmark data may be left on the server.")
+		} else {
+			monitor.InfoLn("Cleanup Done.")
+		}
 	}
-	monitor.InfoLn("Cleanup Done.")
 	ui.Wait()
 	registerUI(nil)
 	if ctx.Bool("web") {
@@ -325,6 +428,7 @@ func runBench(ctx *cli.Context, b bench.Benchmark) error {
 		srv.WaitForKeypress()
 		srv.Shutdown()
 	}
+	fatalIf(probe.NewError(sloErr), "Benchmark did not meet objectives")
 
 	return nil
 }
@@ -492,6 +596,7 @@ func runClientBenchmark(ctx *cli.Context, b bench.Benchmark, cb *clientBenchmark
 	}
 
 	err = b.Start(ctx2, start)
+	injectErrorsArmed.Store(false)
 	ops := retrieveOps()
 	cb.Lock()
 	cb.results = ops
@@ -529,6 +634,8 @@ func runClientBenchmark(ctx *cli.Context, b bench.Benchmark, cb *clientBenchmark
 		final.WarpVersion = GlobalVersion
 		final.WarpDate = GlobalDate
 		final.WarpCommit = GlobalCommit
+		final.Tags = parseRunTags(ctx)
+		final.RequestedMix = requestedMix(ctx)
 		f, err := os.Create(fileName + ".json.zst")
 		if err != nil {
 			console.Errorln("Unable to write benchmark data:", err)
@@ -647,6 +754,7 @@ func checkBenchmark(ctx *cli.Context) {
 
 	_, err := parseInfluxURL(ctx)
 	fatalIf(probe.NewError(err), "invalid influx config")
+	parseRunTags(ctx)
 
 	profs := strings.SplitSeq(ctx.String("serverprof"), ",")
 	for profilerType := range profs {
@@ -671,7 +779,40 @@ func checkBenchmark(ctx *cli.Context) {
 			fatalIf(errDummy(), "syncstart is in the past: %v", t)
 		}
 	}
-	if ctx.Bool("autoterm") {
+	getSLOs(ctx)
+	if f := ctx.Float64("inject-errors"); f < 0 || f > 1 {
+		fatalIf(errDummy(), "inject-errors must be between 0 and 1")
+	}
+	if t := ctx.String("inject-errors.type"); ctx.Float64("inject-errors") > 0 && !slices.Contains(injectErrorTypes, t) {
+		fatalIf(errDummy(), "inject-errors.type %q unrecognized. Possible values are: %v.", t, injectErrorTypes)
+	}
+	if ctx.Duration("deadline") < 0 {
+		fatalIf(errDummy(), "deadline cannot be negative")
+	}
+	if ctx.Duration("idle-before-measure") < 0 {
+		fatalIf(errDummy(), "idle-before-measure cannot be negative")
+	}
+	if ctx.Duration("idle-before-measure") > 0 && ctx.String("syncstart") != "" {
+		fatalIf(errDummy(), "idle-before-measure cannot be combined with syncstart")
+	}
+	if ctx.Bool("prepare-only") && ctx.String("warp-client") != "" {
+		fatalIf(errDummy(), "prepare-only cannot be used with warp-client")
+	}
+	if ctx.Duration("deadline") > 0 && ctx.String("warp-client") != "" {
+		fatalIf(errDummy(), "deadline cannot be used with warp-client")
+	}
+	if ctx.String("control-addr") != "" && ctx.String("warp-client") != "" {
+		fatalIf(errDummy(), "control-addr cannot be used with warp-client")
+	}
+	if ctx.Bool("autowarmup") {
+		if ctx.Bool("full") {
+			fatalIf(errDummy(), "autowarmup cannot be used with --full")
+		}
+		if ctx.String("warp-client") != "" {
+			fatalIf(errDummy(), "autowarmup cannot be used with warp-client")
+		}
+	}
+	if ctx.Bool("autoterm") || ctx.Bool("autowarmup") {
 		// TODO: autoterm cannot be used when in client/server mode
 		if ctx.Duration("autoterm.dur") <= 0 {
 			fatalIf(errDummy(), "autoterm.dur cannot be zero or negative")
@@ -139,6 +139,7 @@ func runServerBenchmark(ctx *cli.Context, b bench.Benchmark) (bool, error) {
 		"help":               {},
 		"syncstart":          {},
 		"analyze.out":        {},
+		"slo":                {},
 	}
 	transformFlags := map[string]func(flag cli.Flag) (string, error){
 		// Special handling for hosts, we read files and expand it.
@@ -235,9 +236,9 @@ func runServerBenchmark(ctx *cli.Context, b bench.Benchmark) (bool, error) {
 	if err != nil {
 		return true, err
 	}
-	tStart := time.Now().Add(benchmarkWait)
+	tStart := time.Now().Add(benchmarkWait + ctx.Duration("idle-before-measure"))
 	benchDur := ctx.Duration("duration")
-	err = conns.startStageAll(stageBenchmark, time.Now().Add(benchmarkWait), false)
+	err = conns.startStageAll(stageBenchmark, tStart, false)
 	if err != nil {
 		errorLn("Failed to start all clients", err)
 	}
@@ -266,6 +267,9 @@ func runServerBenchmark(ctx *cli.Context, b bench.Benchmark) (bool, error) {
 	}
 	prof.stop(context.Background(), ctx, fileName+".profiles.zip")
 
+	// Objectives are checked when the report is printed, but only fail the run after cleanup.
+	var sloErr error
+
 	ui.SetPhase("Downloading Operations")
 	if updates == nil {
 		downloaded := conns.downloadOps()
@@ -303,7 +307,8 @@ func runServerBenchmark(ctx *cli.Context, b bench.Benchmark) (bool, error) {
 		monitor.OperationsReady(allOps, fileName, commandLine(ctx))
 		ui.Update(tea.Quit())
 		ui.Wait()
-		printAnalysis(ctx, os.Stdout, allOps)
+		aggr := printAnalysis(ctx, os.Stdout, allOps)
+		sloErr = checkSLOs(ctx, aggr)
 	} else {
 		final := conns.downloadAggr()
 		if final.Total.TotalRequests == 0 {
@@ -313,6 +318,8 @@ func runServerBenchmark(ctx *cli.Context, b bench.Benchmark) (bool, error) {
 		final.WarpVersion = GlobalVersion
 		final.WarpDate = GlobalDate
 		final.WarpCommit = GlobalCommit
+		final.Tags = parseRunTags(ctx)
+		final.RequestedMix = requestedMix(ctx)
 		f, err := os.Create(fileName + ".json.zst")
 		if err != nil {
 			monitor.Errorln("Unable to write benchmark data:", err)
@@ -348,6 +355,7 @@ func runServerBenchmark(ctx *cli.Context, b bench.Benchmark) (bool, error) {
 		ui.Wait()
 		fmt.Println("")
 		fmt.Println(rep)
+		sloErr = checkSLOs(ctx, &final)
 	}
 
 	if !ctx.Bool("keep-data") && !ctx.Bool("noclear") {
@@ -371,6 +379,7 @@ func runServerBenchmark(ctx *cli.Context, b bench.Benchmark) (bool, error) {
 		srv.WaitForKeypress()
 		srv.Shutdown()
 	}
+	fatalIf(probe.NewError(sloErr), "Benchmark did not meet objectives")
 
 	return true, nil
 }
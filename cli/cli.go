@@ -24,6 +24,7 @@ import (
 	"runtime"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	mprofile "github.com/bygui86/multi-profile/v2"
@@ -117,8 +118,18 @@ var appCmds, benchCmds []cli.Command
 
 func combineFlags(flags ...[]cli.Flag) []cli.Flag {
 	var dst []cli.Flag
+	seen := make(map[string]struct{})
 	for _, fl := range flags {
-		dst = append(dst, fl...)
+		for _, flag := range fl {
+			for name := range strings.SplitSeq(flag.GetName(), ",") {
+				name = strings.TrimSpace(name)
+				if _, ok := seen[name]; ok {
+					panic(fmt.Sprintf("duplicate flag %q", name))
+				}
+				seen[name] = struct{}{}
+			}
+			dst = append(dst, flag)
+		}
 	}
 	return dst
 }
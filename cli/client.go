@@ -29,6 +29,7 @@ import (
 	"os"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/minio/cli"
@@ -194,7 +195,7 @@ func getClient(ctx *cli.Context, host string) (*minio.Client, error) {
 	} else if ctx.String("lookup") == "path" {
 		lookup = minio.BucketLookupPath
 	}
-	cl, err := minio.New(host, &minio.Options{
+	opts := minio.Options{
 		Creds:           creds,
 		Secure:          ctx.Bool("tls") || ctx.Bool("ktls"),
 		Region:          ctx.String("region"),
@@ -202,7 +203,13 @@ func getClient(ctx *cli.Context, host string) (*minio.Client, error) {
 		CustomMD5:       md5simd.NewServer().NewHash,
 		Transport:       transport,
 		TrailingHeaders: useTrailingHeaders.Load(),
-	})
+	}
+	if ctx.Float64("inject-errors") > 0 {
+		// Injected errors would otherwise be retried and only show up as latency.
+		// Since the client is shared, prepare and cleanup are also run without retries.
+		opts.MaxRetries = 1
+	}
+	cl, err := minio.New(host, &opts)
 	if err != nil {
 		return nil, err
 	}
@@ -216,14 +223,96 @@ func getClient(ctx *cli.Context, host string) (*minio.Client, error) {
 }
 
 func clientTransport(ctx *cli.Context) http.RoundTripper {
+	var tr http.RoundTripper
 	switch {
 	case ctx.Bool("ktls"):
-		return clientTransportKTLS(ctx)
+		tr = clientTransportKTLS(ctx)
 	case ctx.Bool("tls"):
-		return clientTransportTLS(ctx)
+		tr = clientTransportTLS(ctx)
 	default:
-		return clientTransportDefault(ctx)
+		tr = clientTransportDefault(ctx)
+	}
+	if d := ctx.Duration("inject-latency"); d > 0 {
+		tr = &delayTransport{RoundTripper: tr, delay: d}
+	}
+	if f := ctx.Float64("inject-errors"); f > 0 {
+		tr = &errorTransport{RoundTripper: tr, fraction: f, errType: ctx.String("inject-errors.type")}
+	}
+	if ctx.Bool("conn-stats") {
+		tr = &connStatsTransport{RoundTripper: tr}
+	}
+	return tr
+}
+
+// delayTransport adds a fixed delay before each request.
+// Used for verifying that latency is measured and reported correctly.
+type delayTransport struct {
+	http.RoundTripper
+	delay time.Duration
+}
+
+func (d *delayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t := time.NewTimer(d.delay)
+	defer t.Stop()
+	select {
+	case <-t.C:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+	return d.RoundTripper.RoundTrip(req)
+}
+
+// errorTransport fails a random fraction of requests without sending them.
+// Errors are only injected while injectErrorsArmed is set.
+// Depending on errType the response is '500 Internal Server Error',
+// '503 Service Unavailable' or a timeout error.
+// Used for testing error handling and reporting.
+type errorTransport struct {
+	http.RoundTripper
+	fraction float64
+	errType  string
+}
+
+// injectErrorsArmed is set while the benchmark is running,
+// so prepare and cleanup are not affected by injected errors.
+var injectErrorsArmed atomic.Bool
+
+// injectErrorTypes contains the supported types of injected errors.
+var injectErrorTypes = []string{"500", "503", "timeout"}
+
+// errInjectedTimeout is returned for injected timeouts.
+var errInjectedTimeout error = injectedTimeout{}
+
+type injectedTimeout struct{}
+
+func (injectedTimeout) Error() string   { return "injected timeout" }
+func (injectedTimeout) Timeout() bool   { return true }
+func (injectedTimeout) Temporary() bool { return true }
+
+func (e *errorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !injectErrorsArmed.Load() || rand.Float64() >= e.fraction {
+		return e.RoundTripper.RoundTrip(req)
+	}
+	if req.Body != nil {
+		req.Body.Close()
+	}
+	code := http.StatusServiceUnavailable
+	switch e.errType {
+	case "timeout":
+		return nil, errInjectedTimeout
+	case "500":
+		code = http.StatusInternalServerError
 	}
+	return &http.Response{
+		Status:     fmt.Sprintf("%d %s", code, http.StatusText(code)),
+		StatusCode: code,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{},
+		Body:       http.NoBody,
+		Request:    req,
+	}, nil
 }
 
 // parseHosts will parse the host parameter given.
@@ -37,6 +37,10 @@ func clientTransportKTLS(ctx *cli.Context) stdHttp.RoundTripper {
 		MinVersion:         tls.VersionTLS12,
 		InsecureSkipVerify: ctx.Bool("insecure"),
 		ClientSessionCache: tls.NewLRUClientSessionCache(1024), // up to 1024 nodes
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			countTLSHandshake(cs.DidResume)
+			return nil
+		},
 
 		// Extra configs
 		KernelTX: true,
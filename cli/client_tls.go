@@ -19,8 +19,10 @@ package cli
 
 import (
 	"crypto/tls"
+	"fmt"
 	"net/http"
 	"os"
+	"sync/atomic"
 
 	"github.com/minio/cli"
 )
@@ -35,6 +37,10 @@ func clientTransportTLS(ctx *cli.Context) http.RoundTripper {
 		MinVersion:         tls.VersionTLS12,
 		InsecureSkipVerify: ctx.Bool("insecure"),
 		ClientSessionCache: tls.NewLRUClientSessionCache(1024), // up to 1024 nodes
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			countTLSHandshake(cs.DidResume)
+			return nil
+		},
 	}
 
 	if ctx.Bool("debug") {
@@ -43,3 +49,31 @@ func clientTransportTLS(ctx *cli.Context) http.RoundTripper {
 
 	return newClientTransport(ctx, withTLSConfig(tlsConfig))
 }
+
+// tlsHandshakes counts client TLS handshakes and how many of them resumed a session.
+var tlsHandshakes struct {
+	total, resumed atomic.Int64
+}
+
+func countTLSHandshake(resumed bool) {
+	tlsHandshakes.total.Add(1)
+	if resumed {
+		tlsHandshakes.resumed.Add(1)
+	}
+}
+
+// resetTLSHandshakes resets the handshake counters.
+func resetTLSHandshakes() {
+	tlsHandshakes.total.Store(0)
+	tlsHandshakes.resumed.Store(0)
+}
+
+// tlsHandshakeStats returns a summary of TLS handshakes since last reset.
+// Returns an empty string if there were no handshakes.
+func tlsHandshakeStats() string {
+	total, resumed := tlsHandshakes.total.Load(), tlsHandshakes.resumed.Load()
+	if total == 0 {
+		return ""
+	}
+	return fmt.Sprintf("TLS handshakes: %d, full: %d, resumed: %d (%.1f%%)", total, total-resumed, resumed, 100*float64(resumed)/float64(total))
+}
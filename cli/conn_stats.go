@@ -0,0 +1,78 @@
+/*
+ * Warp (C) 2019-2025 MinIO, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package cli
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptrace"
+	"slices"
+	"sync"
+)
+
+// connRequests counts requests sent on each connection.
+var connRequests struct {
+	mu sync.Mutex
+	n  map[string]int
+}
+
+// connStatsTransport records which connection each request is sent on.
+type connStatsTransport struct {
+	http.RoundTripper
+}
+
+func (c *connStatsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	trace := &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) {
+			conn := info.Conn.LocalAddr().String() + "->" + info.Conn.RemoteAddr().String()
+			connRequests.mu.Lock()
+			if connRequests.n == nil {
+				connRequests.n = make(map[string]int)
+			}
+			connRequests.n[conn]++
+			connRequests.mu.Unlock()
+		},
+	}
+	return c.RoundTripper.RoundTrip(req.WithContext(httptrace.WithClientTrace(req.Context(), trace)))
+}
+
+// resetConnStats resets the request counts.
+func resetConnStats() {
+	connRequests.mu.Lock()
+	connRequests.n = nil
+	connRequests.mu.Unlock()
+}
+
+// connStats returns a summary of the number of requests per connection since last reset.
+// Returns an empty string if no connections were recorded.
+func connStats() string {
+	connRequests.mu.Lock()
+	counts := make([]int, 0, len(connRequests.n))
+	total := 0
+	for _, n := range connRequests.n {
+		counts = append(counts, n)
+		total += n
+	}
+	connRequests.mu.Unlock()
+	if len(counts) == 0 {
+		return ""
+	}
+	slices.Sort(counts)
+	return fmt.Sprintf("Connections: %d, requests per connection: min %d, median %d, max %d, average %.1f",
+		len(counts), counts[0], counts[len(counts)/2], counts[len(counts)-1], float64(total)/float64(len(counts)))
+}
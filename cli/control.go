@@ -0,0 +1,148 @@
+/*
+ * Warp (C) 2019-2025 MinIO, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/minio/warp/pkg/aggregate"
+	"github.com/minio/warp/pkg/bench"
+)
+
+// controlServer accepts line based 'pause', 'resume' and 'status' commands.
+type controlServer struct {
+	l       net.Listener
+	pauser  *bench.Pauser
+	updates chan<- aggregate.UpdateReq
+	start   time.Time
+}
+
+// startControlServer will listen on addr.
+// Unix sockets can be specified as 'unix:/path/to/socket', otherwise TCP is used.
+// updates may be nil, in which case status will not include throughput.
+func startControlServer(addr string, pauser *bench.Pauser, updates chan<- aggregate.UpdateReq, start time.Time) (*controlServer, error) {
+	network := "tcp"
+	if path, ok := strings.CutPrefix(addr, "unix:"); ok {
+		network = "unix"
+		addr = path
+		// Remove stale socket from previous runs.
+		// Anything else is left for Listen to fail on.
+		if fi, err := os.Lstat(addr); err == nil && fi.Mode()&os.ModeSocket != 0 {
+			_ = os.Remove(addr)
+		}
+	}
+	l, err := net.Listen(network, addr)
+	if err != nil {
+		return nil, err
+	}
+	s := &controlServer{l: l, pauser: pauser, updates: updates, start: start}
+	go s.serve()
+	return s, nil
+}
+
+func (s *controlServer) serve() {
+	for {
+		conn, err := s.l.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *controlServer) handle(conn net.Conn) {
+	defer conn.Close()
+	scanner := bufio.NewScanner(conn)
+	for scanner.Scan() {
+		var resp string
+		switch cmd := strings.ToLower(strings.TrimSpace(scanner.Text())); cmd {
+		case "":
+			continue
+		case "pause":
+			resp = "already paused"
+			if s.pauser.Pause() {
+				resp = "paused"
+			}
+		case "resume":
+			resp = "not paused"
+			if s.pauser.Resume() {
+				resp = "resumed"
+			}
+		case "status":
+			resp = s.status()
+		default:
+			resp = fmt.Sprintf("unknown command %q. Use 'pause', 'resume' or 'status'", cmd)
+		}
+		if _, err := fmt.Fprintln(conn, resp); err != nil {
+			return
+		}
+	}
+}
+
+func (s *controlServer) status() string {
+	state := "running"
+	if s.pauser.Paused() {
+		state = "paused"
+	}
+	elapsed := max(time.Since(s.start), 0).Round(time.Second)
+	res := fmt.Sprintf("state: %s, elapsed: %v", state, elapsed)
+	if s.updates == nil {
+		return res
+	}
+	respCh := make(chan *aggregate.Realtime, 1)
+	s.updates <- aggregate.UpdateReq{C: respCh}
+	var rt *aggregate.Realtime
+	select {
+	case rt = <-respCh:
+	case <-time.After(time.Second):
+	}
+	if rt == nil {
+		return res
+	}
+	res += fmt.Sprintf(", requests: %d, errors: %d", rt.Total.TotalRequests, rt.Total.TotalErrors)
+	tp := rt.Total.Throughput
+	if tp.Segmented == nil || len(tp.Segmented.Segments) == 0 {
+		return res
+	}
+	segs := tp.Segmented.Segments
+	segs.SortByStartTime()
+	// Segments are only added when operations complete,
+	// so an old last segment means nothing is completing right now.
+	var current aggregate.SegmentSmall
+	if last := segs[len(segs)-1]; time.Since(last.Start) < 10*time.Second {
+		current = last
+	}
+	res += fmt.Sprintf(", throughput: %.1f obj/s", current.OPS)
+	if tp.Bytes > 0 {
+		res += ", " + bench.Throughput(current.BPS).String()
+	}
+	return res
+}
+
+// Close stops accepting new connections.
+func (s *controlServer) Close() error {
+	if s == nil {
+		return nil
+	}
+	return s.l.Close()
+}
@@ -267,6 +267,22 @@ var ioFlags = []cli.Flag{
 		Usage:  "Disable HTTP Keep-Alive",
 		Hidden: true,
 	},
+	cli.DurationFlag{
+		Name:   "inject-latency",
+		Usage:  "Add a fixed delay before every request. For testing latency measurements.",
+		Hidden: true,
+	},
+	cli.Float64Flag{
+		Name:   "inject-errors",
+		Usage:  "Fail this fraction of benchmark requests without sending them. Disables retries. For testing error handling.",
+		Hidden: true,
+	},
+	cli.StringFlag{
+		Name:   "inject-errors.type",
+		Usage:  "Type of injected errors. Can be '500', '503' or 'timeout'.",
+		Value:  "503",
+		Hidden: true,
+	},
 	cli.BoolFlag{
 		Name:   "http2",
 		Usage:  "enable HTTP2 support if server supports it",
@@ -281,6 +297,14 @@ var ioFlags = []cli.Flag{
 		EnvVar: appNameUC + "_INFLUXDB_CONNECT",
 		Usage:  "Send operations to InfluxDB. Specify as 'http://<token>@<hostname>:<port>/<bucket>/<org>'",
 	},
+	cli.BoolFlag{
+		Name:  "conn-stats",
+		Usage: "Print how requests were distributed over connections and TLS session resumption after the benchmark.",
+	},
+	cli.StringSliceFlag{
+		Name:  "run-tag",
+		Usage: "Tag the run using the format <key>=<value>. Tags are added to benchmark data and InfluxDB output. Can be used multiple times.",
+	},
 	cli.Float64Flag{
 		Name:  "rps-limit",
 		Value: 0,
@@ -302,6 +326,19 @@ var ioFlags = []cli.Flag{
 	},
 }
 
+// parseRunTags returns the tags specified with --run-tag.
+func parseRunTags(ctx *cli.Context) map[string]string {
+	tags := make(map[string]string)
+	for _, v := range ctx.StringSlice("run-tag") {
+		key, value, ok := strings.Cut(v, "=")
+		if !ok || key == "" {
+			fatalIf(errDummy(), "--run-tag takes key=value arguments, got %q", v)
+		}
+		tags[key] = value
+	}
+	return tags
+}
+
 func getCommon(ctx *cli.Context, src func() generator.Source) bench.Common {
 	var extra []chan<- bench.Operation
 	u, err := parseInfluxURL(ctx)
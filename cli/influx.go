@@ -48,7 +48,7 @@ func newInfluxDB(ctx *cli.Context, wg *sync.WaitGroup) chan<- bench.Operation {
 		tagValues, err = url.ParseQuery(u.RawQuery)
 		errorIf(probe.NewError(err), "unable to parse tags")
 	}
-	tags := make(map[string]string, len(tagValues)+1)
+	tags := parseRunTags(ctx)
 	for key, tag := range tagValues {
 		if len(tag) > 0 && len(key) > 0 {
 			tags[key] = tag[0]
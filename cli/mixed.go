@@ -106,6 +106,28 @@ func mainMixed(ctx *cli.Context) error {
 	return runBench(ctx, &b)
 }
 
+// requestedMix returns the requested distribution of operations as fractions.
+// Returns nil if the benchmark has no distribution flags.
+func requestedMix(ctx *cli.Context) map[string]float64 {
+	mix := map[string]float64{
+		http.MethodGet:    ctx.Float64("get-distrib"),
+		"STAT":            ctx.Float64("stat-distrib"),
+		http.MethodPut:    ctx.Float64("put-distrib"),
+		http.MethodDelete: ctx.Float64("delete-distrib"),
+	}
+	total := 0.0
+	for _, v := range mix {
+		total += max(v, 0)
+	}
+	if total == 0 {
+		return nil
+	}
+	for op, v := range mix {
+		mix[op] = max(v, 0) / total
+	}
+	return mix
+}
+
 func checkMixedSyntax(ctx *cli.Context) {
 	if ctx.NArg() > 0 {
 		console.Fatal("Command takes no arguments")
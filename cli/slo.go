@@ -0,0 +1,55 @@
+/*
+ * Warp (C) 2019-2025 MinIO, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/minio/cli"
+	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/warp/pkg/aggregate"
+)
+
+// getSLOs returns the objectives specified with --slo.
+func getSLOs(ctx *cli.Context) []aggregate.SLO {
+	slos, err := aggregate.ParseSLOs(ctx.String("slo"))
+	fatalIf(probe.NewError(err), "Invalid slo specified")
+	return slos
+}
+
+// sloChecker is implemented by the live and the analyzed benchmark results.
+type sloChecker interface {
+	CheckSLOs(slos []aggregate.SLO) aggregate.SLOResults
+}
+
+// checkSLOs will print the result of checking --slo objectives against final.
+// An error is returned if any objective was not met.
+func checkSLOs(ctx *cli.Context, final sloChecker) error {
+	slos := getSLOs(ctx)
+	if len(slos) == 0 {
+		return nil
+	}
+	res := final.CheckSLOs(slos)
+	if !globalJSON {
+		fmt.Println(res.Report(aggregate.ReportOptions{Color: !globalNoColor}))
+	}
+	if n := res.Failed(); n > 0 {
+		return fmt.Errorf("%d of %d slo checks failed", n, len(res))
+	}
+	return nil
+}
@@ -39,6 +39,7 @@ import (
 type ui struct {
 	progress     progress.Model
 	pct          atomic.Pointer[float64]
+	pctStart     atomic.Pointer[time.Time]
 	phase        atomic.Pointer[string]
 	phaseTxt     atomic.Pointer[string]
 	updates      atomic.Pointer[chan<- aggregate.UpdateReq]
@@ -166,6 +167,9 @@ func (u *ui) View() string {
 	res += defaultStyle.Render("\n λ ")
 	if u.showProgress {
 		res += u.progress.View() + "\n"
+		if eta := u.eta(); eta != "" {
+			res += defaultStyle.Render("   ETA: "+eta) + "\n"
+		}
 	} else {
 		res += "\n"
 	}
@@ -212,6 +216,9 @@ func (u *ui) View() string {
 				var totalDur float64
 				var totalTTFB float64
 				var totalRequests int
+				// Single sized segments also have the 99th percentile of the segment.
+				var totalP99 float64
+				var p99Requests int
 				for _, reqs := range resp.ByOpType[op].Requests {
 					if len(reqs) == 0 {
 						continue
@@ -226,6 +233,8 @@ func (u *ui) View() string {
 							totalTTFB += lastReq.Single.FirstByte.AverageMillis
 						}
 						totalRequests += lastReq.Single.MergedEntries
+						totalP99 += lastReq.Single.Dur99Millis
+						p99Requests += lastReq.Single.MergedEntries
 					}
 					if lastReq.Multi != nil {
 						for _, reqs := range lastReq.Multi.ByHost {
@@ -239,6 +248,9 @@ func (u *ui) View() string {
 				}
 				if totalRequests > 0 {
 					stats += fmt.Sprintf(", %.1f ms/req", totalDur/float64(totalRequests))
+					if p99Requests > 0 {
+						stats += fmt.Sprintf(", p99: %.1fms", totalP99/float64(p99Requests))
+					}
 					if totalTTFB > 0 {
 						stats += fmt.Sprintf(", TTFB: %.1fms", totalTTFB/float64(totalRequests))
 					}
@@ -282,6 +294,8 @@ func (u *ui) StartPrepare(caption string, progress <-chan float64, ur chan<- agg
 	}
 	if progress != nil {
 		go func() {
+			now := time.Now()
+			u.pctStart.Store(&now)
 			for p := range progress {
 				u.pct.Store(&p)
 			}
@@ -292,6 +306,20 @@ func (u *ui) StartPrepare(caption string, progress <-chan float64, ur chan<- agg
 	}
 }
 
+// eta returns the estimated remaining time of the current progress.
+// Returns an empty string if there is no progress or it is too early to tell.
+func (u *ui) eta() string {
+	p, start := u.pct.Load(), u.pctStart.Load()
+	if p == nil || start == nil || *p < 0.01 || *p >= 1 {
+		return ""
+	}
+	elapsed := time.Since(*start)
+	if elapsed < time.Second {
+		return ""
+	}
+	return time.Duration(float64(elapsed) * (1 - *p) / *p).Round(time.Second).String()
+}
+
 func (u *ui) StartBenchmark(caption string, start, end time.Time, ur chan<- aggregate.UpdateReq) {
 	u.phase.Store(&caption)
 	u.phaseTxt.Store(nil)
@@ -58,6 +58,10 @@ type Operation struct {
 	HostNames []string `json:"host_names"`
 	// Subset of errors.
 	FirstErrors []string `json:"first_errors"`
+	// ErrorCounts contains the number of occurrences of each distinct error.
+	ErrorCounts ErrorCounts `json:"error_counts,omitempty"`
+	// InvalidDurations is the number of operations without a positive duration.
+	InvalidDurations int `json:"invalid_durations,omitempty"`
 	// Numbers of hosts
 	Hosts int `json:"hosts"`
 	// Number of warp clients.
@@ -185,12 +189,18 @@ func Aggregate(o bench.Operations, opts Options) Aggregated {
 				start = start.Add(opts.SkipDur)
 				ops = ops.FilterInsideRange(start, end)
 			}
+			for _, op := range ops {
+				if op.InvalidDuration() {
+					a.InvalidDurations++
+				}
+			}
 			errs := ops.FilterErrors()
 			if len(errs) > 0 {
 				a.Errors = len(errs)
 				for _, err := range errs {
+					a.ErrorCounts.add(err.Err, 1)
 					if len(a.FirstErrors) >= 10 {
-						break
+						continue
 					}
 					a.FirstErrors = append(a.FirstErrors, fmt.Sprintf("%s, %s: %v", err.Endpoint, err.End.Round(time.Second), err.Err))
 				}
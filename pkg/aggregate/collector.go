@@ -19,7 +19,10 @@ package aggregate
 
 import (
 	"context"
+	"fmt"
+	"maps"
 	"math"
+	"slices"
 	"sync"
 	"time"
 
@@ -83,73 +86,171 @@ func AutoTerm(ctx context.Context, op string, threshold float64, wantSamples int
 	ctx, cancel := context.WithCancel(ctx)
 	go func() {
 		defer cancel()
-		ticker := time.NewTicker(time.Second)
+		if msg := waitStable(ctx, op, threshold, wantSamples, minDur, updates); msg != "" {
+			console.Eraseline()
+			console.Printf("\r%s. Assuming stability. Terminating benchmark.\n", msg)
+		}
+	}()
+	return ctx
+}
 
-	checkloop:
-		for {
-			select {
-			case <-ctx.Done():
-				ticker.Stop()
-				return
-			case <-ticker.C:
-			}
-			respCh := make(chan *Realtime, 1)
-			req := UpdateReq{C: respCh, Reset: false, Final: false}
-			updates <- req
-			resp := <-respCh
-			if resp == nil {
-				continue
-			}
+// waitStable blocks until throughput of op has been within threshold for the last wantSamples segments.
+// If op is empty, the total for all operations is used.
+// A description of the stable throughput is returned.
+// If ctx is canceled before throughput is stable, an empty string is returned.
+func waitStable(ctx context.Context, op string, threshold float64, wantSamples int, minDur time.Duration, updates chan<- UpdateReq) string {
+	if updates == nil {
+		return ""
+	}
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
 
-			ops := resp.ByOpType[op]
-			if op == "" {
-				ops = &resp.Total
-			}
-			if ops == nil || ops.Throughput.Segmented == nil {
+checkloop:
+	for {
+		select {
+		case <-ctx.Done():
+			return ""
+		case <-ticker.C:
+		}
+		respCh := make(chan *Realtime, 1)
+		req := UpdateReq{C: respCh, Reset: false, Final: false}
+		updates <- req
+		resp := <-respCh
+		if resp == nil {
+			continue
+		}
+
+		ops := resp.ByOpType[op]
+		if op == "" {
+			ops = &resp.Total
+		}
+		if ops == nil || ops.Throughput.Segmented == nil {
+			continue
+		}
+		start, end := ops.StartTime, ops.EndTime
+		if end.Sub(start) <= minDur {
+			// We don't have enough.
+			continue
+		}
+		if len(ops.Throughput.Segmented.Segments) < wantSamples {
+			continue
+		}
+		segs := ops.Throughput.Segmented.Segments
+		// Use last segment as our base.
+		lastSeg := segs[len(segs)-1]
+		mb, objs := lastSeg.BPS, lastSeg.OPS
+		// Only use the segments we are interested in.
+		segs = segs[len(segs)-wantSamples : len(segs)-1]
+		for _, seg := range segs {
+			segMB, segObjs := seg.BPS, seg.OPS
+			if mb > 0 {
+				if math.Abs(mb-segMB) > threshold*mb {
+					continue checkloop
+				}
 				continue
 			}
-			start, end := ops.StartTime, ops.EndTime
-			if end.Sub(start) <= minDur {
-				// We don't have enough.
-				continue
+			if math.Abs(objs-segObjs) > threshold*objs {
+				continue checkloop
 			}
-			if len(ops.Throughput.Segmented.Segments) < wantSamples {
-				continue
+		}
+		// All checks passed.
+		stableFor := time.Duration(ops.Throughput.Segmented.SegmentDurationMillis*(len(segs)+1)) * time.Millisecond
+		if mb > 0 {
+			return fmt.Sprintf("Throughput %0.01fMiB/s within %f%% for %v", mb, threshold*100, stableFor)
+		}
+		return fmt.Sprintf("Throughput %0.01f objects/s within %f%% for %v", objs, threshold*100, stableFor)
+	}
+}
+
+// WaitLatencyStable blocks until the 99th percentile request time of op has been within threshold
+// for at least minDur. Request times are measured in 10 second segments, so the window is rounded up.
+// If op is empty, the total for all operations is used.
+// When objects have random sizes there is no 99th percentile per segment, so the average request time is used.
+// A description of the stable latency is returned.
+// If ctx is canceled before latency is stable, an empty string is returned.
+func WaitLatencyStable(ctx context.Context, op string, threshold float64, minDur time.Duration, updates chan<- UpdateReq) string {
+	if updates == nil {
+		return ""
+	}
+	wantSamples := max(2, int(math.Ceil(minDur.Seconds()/requestSegmentsDur)))
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+checkloop:
+	for {
+		select {
+		case <-ctx.Done():
+			return ""
+		case <-ticker.C:
+		}
+		respCh := make(chan *Realtime, 1)
+		updates <- UpdateReq{C: respCh}
+		resp := <-respCh
+		if resp == nil {
+			continue
+		}
+		ops := resp.ByOpType[op]
+		if op == "" {
+			ops = &resp.Total
+		}
+		if ops == nil {
+			continue
+		}
+		metric, segs := requestLatencySegments(ops.Requests)
+		if len(segs) < wantSamples {
+			continue
+		}
+		// Use last segment as our base.
+		last := segs[len(segs)-1]
+		for _, seg := range segs[len(segs)-wantSamples : len(segs)-1] {
+			if math.Abs(last-seg) > threshold*last {
+				continue checkloop
 			}
-			segs := ops.Throughput.Segmented.Segments
-			// Use last segment as our base.
-			lastSeg := segs[len(segs)-1]
-			mb, objs := lastSeg.BPS, lastSeg.OPS
-			// Only use the segments we are interested in.
-			segs = segs[len(segs)-wantSamples : len(segs)-1]
-			for _, seg := range segs {
-				segMB, segObjs := seg.BPS, seg.OPS
-				if mb > 0 {
-					if math.Abs(mb-segMB) > threshold*mb {
-						continue checkloop
-					}
-					continue
+		}
+		stableFor := time.Duration(wantSamples*requestSegmentsDur) * time.Second
+		return fmt.Sprintf("Request %s %0.01fms within %f%% for %v", metric, last, threshold*100, stableFor)
+	}
+}
+
+// requestLatencySegments returns the request time in milliseconds of each request segment, oldest first.
+// Segments of all clients starting at the same time are merged.
+// If all requests have the same size the 99th percentile is returned, otherwise the average.
+func requestLatencySegments(reqs map[string]RequestSegments) (metric string, segs []float64) {
+	single := make(map[time.Time]*SingleSizedRequests)
+	multi := make(map[time.Time][2]float64)
+	for _, client := range reqs {
+		for _, seg := range client {
+			switch {
+			case seg.Single != nil && !seg.Single.Skipped && seg.Single.MergedEntries > 0:
+				ss := single[seg.StartTime]
+				if ss == nil {
+					ss = &SingleSizedRequests{}
+					single[seg.StartTime] = ss
 				}
-				if math.Abs(objs-segObjs) > threshold*objs {
-					continue checkloop
+				ss.add(*seg.Single)
+			case seg.Multi != nil && !seg.Multi.Skipped:
+				// Average weighted by the number of requests in each size range.
+				v := multi[seg.StartTime]
+				for _, r := range seg.Multi.BySize {
+					v[0] += r.AvgDurationMillis / float64(max(r.MergedEntries, 1)) * float64(r.Requests)
+					v[1] += float64(r.Requests)
+				}
+				if v[1] > 0 {
+					multi[seg.StartTime] = v
 				}
 			}
-			// All checks passed.
-			if mb > 0 {
-				console.Eraseline()
-				console.Printf("\rThroughput %0.01fMiB/s within %f%% for %v. Assuming stability. Terminating benchmark.\n",
-					mb, threshold*100,
-					time.Duration(ops.Throughput.Segmented.SegmentDurationMillis*(len(segs)+1))*time.Millisecond)
-			} else {
-				console.Eraseline()
-				console.Printf("\rThroughput %0.01f objects/s within %f%% for %v. Assuming stability. Terminating benchmark.\n",
-					objs, threshold*100,
-					time.Duration(ops.Throughput.Segmented.SegmentDurationMillis*(len(segs)+1))*time.Millisecond)
-			}
-			return
 		}
-	}()
-	return ctx
+	}
+	if len(multi) > 0 {
+		for _, start := range slices.SortedFunc(maps.Keys(multi), time.Time.Compare) {
+			segs = append(segs, multi[start][0]/multi[start][1])
+		}
+		return "average", segs
+	}
+	for _, start := range slices.SortedFunc(maps.Keys(single), time.Time.Compare) {
+		segs = append(segs, single[start].Dur99Millis/float64(single[start].MergedEntries))
+	}
+	return "p99", segs
 }
 
 func (c *collector) Receiver() chan<- bench.Operation {
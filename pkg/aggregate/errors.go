@@ -0,0 +1,93 @@
+/*
+ * Warp (C) 2019-2025 MinIO, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package aggregate
+
+import (
+	"cmp"
+	"regexp"
+	"slices"
+)
+
+// maxErrorCounts is the maximum number of distinct errors counted.
+// Errors beyond this are counted as otherErrors.
+const maxErrorCounts = 100
+
+const otherErrors = "(other errors)"
+
+// ErrorCounts contains the number of times each distinct error was seen.
+type ErrorCounts map[string]int
+
+// ErrorCount is an error and the number of times it was seen.
+type ErrorCount struct {
+	Err string
+	N   int
+}
+
+var (
+	// errURLRe matches quoted URLs, as included by url.Error.
+	errURLRe = regexp.MustCompile(`"https?://[^"]*"`)
+	// errAddrRe matches IPv4 and IPv6 addresses with optional ports.
+	errAddrRe = regexp.MustCompile(`\b\d{1,3}(\.\d{1,3}){3}(:\d+)?\b|\[[0-9a-fA-F:.]+\](:\d+)?`)
+	// errValueRe matches numeric values, like 'want:1024, got:512'.
+	errValueRe = regexp.MustCompile(`([:=] ?|\b(got|want) )\d+(\.\d+)?`)
+)
+
+// normalizeError removes request specific parts of an error,
+// so the same error on different requests is counted together.
+func normalizeError(err string) string {
+	err = errURLRe.ReplaceAllString(err, `"<url>"`)
+	err = errAddrRe.ReplaceAllString(err, "<addr>")
+	return errValueRe.ReplaceAllString(err, "${1}N")
+}
+
+// add n occurrences of err after normalizing it.
+func (e *ErrorCounts) add(err string, n int) {
+	if *e == nil {
+		*e = make(ErrorCounts)
+	}
+	err = normalizeError(err)
+	if _, ok := (*e)[err]; !ok && len(*e) >= maxErrorCounts {
+		err = otherErrors
+	}
+	(*e)[err] += n
+}
+
+// Merge other into e.
+func (e *ErrorCounts) Merge(other ErrorCounts) {
+	for err, n := range other {
+		e.add(err, n)
+	}
+}
+
+// Top returns up to n of the most common errors, most common first.
+func (e ErrorCounts) Top(n int) []ErrorCount {
+	res := make([]ErrorCount, 0, len(e))
+	for err, cnt := range e {
+		res = append(res, ErrorCount{Err: err, N: cnt})
+	}
+	slices.SortFunc(res, func(a, b ErrorCount) int {
+		if c := cmp.Compare(b.N, a.N); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Err, b.Err)
+	})
+	if len(res) > n {
+		res = res[:n]
+	}
+	return res
+}
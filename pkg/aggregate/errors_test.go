@@ -0,0 +1,81 @@
+/*
+ * Warp (C) 2019-2025 MinIO, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package aggregate
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeError(t *testing.T) {
+	tests := map[string]string{
+		"The specified key does not exist.": "The specified key does not exist.",
+		"503 Service Unavailable":           "503 Service Unavailable",
+		`Get "http://127.0.0.1:9000/bucket/obj-123?partNumber=2": dial tcp 127.0.0.1:9000: connect: connection refused`: `Get "<url>": dial tcp <addr>: connect: connection refused`,
+		"read tcp 10.0.0.1:54321->10.0.0.2:9000: read: connection reset by peer":                                        "read tcp <addr>-><addr>: read: connection reset by peer",
+		"dial tcp [::1]:9000: connect: connection refused":                                                              "dial tcp <addr>: connect: connection refused",
+		"unexpected download size. want:1048576, got:4096":                                                              "unexpected download size. want:N, got:N",
+		"unexpected download size. want: 1048576, got 4096":                                                             "unexpected download size. want: N, got N",
+	}
+	for in, want := range tests {
+		if got := normalizeError(in); got != want {
+			t.Errorf("normalizeError(%q):\ngot  %q\nwant %q", in, got, want)
+		}
+		if got := normalizeError(want); got != want {
+			t.Errorf("normalizeError(%q) is not stable: %q", want, got)
+		}
+	}
+}
+
+func TestErrorCounts(t *testing.T) {
+	var e ErrorCounts
+	for i := range maxErrorCounts + 10 {
+		e.add(fmt.Sprintf("error %c%c", 'a'+i/26, 'a'+i%26), 1)
+	}
+	// The other errors are counted separately.
+	if len(e) != maxErrorCounts+1 {
+		t.Fatalf("got %d distinct errors, want %d", len(e), maxErrorCounts+1)
+	}
+	if got := e[otherErrors]; got != 10 {
+		t.Errorf("got %d other errors, want 10", got)
+	}
+	// Known errors are still counted when full.
+	e.add("error aa", 2)
+	if got := e["error aa"]; got != 3 {
+		t.Errorf("got %d, want 3", got)
+	}
+
+	var a, b ErrorCounts
+	a.add("access denied", 2)
+	a.add("conflict", 5)
+	b.add("access denied", 5)
+	b.add("not found", 1)
+	b.add("bucket exists", 1)
+	a.Merge(b)
+	want := []ErrorCount{{Err: "access denied", N: 7}, {Err: "conflict", N: 5}, {Err: "bucket exists", N: 1}}
+	if got := a.Top(3); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got := a.Top(10); len(got) != 4 {
+		t.Errorf("got %d errors, want 4", len(got))
+	}
+	if got := ErrorCounts(nil).Top(3); len(got) != 0 {
+		t.Errorf("got %v, want none", got)
+	}
+}
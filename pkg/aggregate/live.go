@@ -45,6 +45,10 @@ type Realtime struct {
 	WarpVersion string `json:"warp_version,omitempty"`
 	WarpCommit  string `json:"warp_commit,omitempty"`
 	WarpDate    string `json:"warp_date,omitempty"`
+	// Tags are user supplied key/values describing the run.
+	Tags map[string]string `json:"tags,omitempty"`
+	// RequestedMix is the requested fraction of each operation type, if any.
+	RequestedMix map[string]float64 `json:"requested_mix,omitempty"`
 
 	Total    LiveAggregate             `json:"total"`
 	ByOpType map[string]*LiveAggregate `json:"by_op_type,omitempty"`
@@ -75,6 +79,10 @@ type LiveAggregate struct {
 
 	// Subset of errors.
 	FirstErrors []string `json:"first_errors"`
+	// ErrorCounts contains the number of occurrences of each distinct error.
+	ErrorCounts ErrorCounts `json:"error_counts,omitempty"`
+	// InvalidDurations is the number of operations without a positive duration.
+	InvalidDurations int `json:"invalid_durations,omitempty"`
 	// Numbers of hosts
 	Hosts MapAsSlice `json:"hosts"`
 	// Number of warp clients.
@@ -140,8 +148,12 @@ func (l *LiveAggregate) Add(o bench.Operation) {
 		if len(l.FirstErrors) < maxFirstErrors {
 			l.FirstErrors = append(l.FirstErrors, o.Err)
 		}
+		l.ErrorCounts.add(o.Err, 1)
 		l.TotalErrors++
 	}
+	if o.InvalidDuration() {
+		l.InvalidDurations++
+	}
 	if l.ThroughputByHost == nil {
 		l.ThroughputByHost = make(map[string]Throughput)
 	}
@@ -151,6 +163,10 @@ func (l *LiveAggregate) Add(o bench.Operation) {
 	l.ThroughputByHost[o.Endpoint] = l.ThroughputByHost[o.Endpoint].Add(o)
 	l.ThroughputByClient[o.ClientID] = l.ThroughputByClient[o.ClientID].Add(o)
 	l.throughput.Add(o)
+	if o.InvalidDuration() {
+		// Keep these out of latency statistics.
+		return
+	}
 	if l.requests == nil {
 		l.requests = make(map[string]liveRequests, 10)
 	}
@@ -176,6 +192,8 @@ func (l *LiveAggregate) Merge(l2 LiveAggregate) {
 	if len(l.FirstErrors) > maxFirstErrors {
 		l.FirstErrors = l.FirstErrors[:maxFirstErrors]
 	}
+	l.ErrorCounts.Merge(l2.ErrorCounts)
+	l.InvalidDurations += l2.InvalidDurations
 	l.Clients.AddMap(l2.Clients)
 	l.Hosts.AddMap(l2.Hosts)
 	l.TotalRequests += l2.TotalRequests
@@ -241,6 +259,7 @@ func (l LiveAggregate) Update() LiveAggregate {
 	dst.Clients = l.Clients.Clone()
 	dst.Hosts = l.Hosts.Clone()
 	dst.FirstErrors = slices.Clone(l.FirstErrors)
+	dst.ErrorCounts = maps.Clone(l.ErrorCounts)
 	dst.Title += " (update)"
 
 	return dst
@@ -301,6 +320,14 @@ func (o ReportOptions) col(ca color.Attribute, s string) string {
 	return color.New(ca).Sprint(s)
 }
 
+// reportInvalidDurations warns about operations without a positive duration.
+func (l LiveAggregate) reportInvalidDurations(printfColor func(ca color.Attribute, format string, args ...any)) {
+	if l.InvalidDurations == 0 {
+		return
+	}
+	printfColor(color.FgHiYellow, " * Warning: %d operations did not have a positive duration, likely caused by clock adjustments. They are counted as errors and excluded from request times.\n", l.InvalidDurations)
+}
+
 func (l LiveAggregate) Report(op string, o ReportOptions) string {
 	dst := bytes.NewBuffer(make([]byte, 0, 1024))
 	printfColor := o.printfColor(dst)
@@ -312,6 +339,9 @@ func (l LiveAggregate) Report(op string, o ReportOptions) string {
 		printfColor(color.FgHiYellow, "Skipping %s too few samples. Longer benchmark run required for reliable results.\n\n", op)
 		if data.TotalErrors > 0 {
 			printfColor(color.FgHiRed, "Errors: %d\n", data.TotalErrors)
+			for _, e := range data.ErrorCounts.Top(3) {
+				printfColor(color.FgWhite, " - %dx: %s\n", e.N, e.Err)
+			}
 			if details {
 				console.SetColor("Print", color.New(color.FgWhite))
 				printfColor(color.FgWhite, "- First Errors:\n")
@@ -321,6 +351,7 @@ func (l LiveAggregate) Report(op string, o ReportOptions) string {
 			}
 			dst.WriteByte('\n')
 		}
+		data.reportInvalidDurations(printfColor)
 		return dst.String()
 	}
 
@@ -347,6 +378,9 @@ func (l LiveAggregate) Report(op string, o ReportOptions) string {
 	printfColor(color.FgWhite, " * Average: %v\n", col(color.FgWhite, data.Throughput.StringDetails(details)))
 	if data.TotalErrors > 0 {
 		printfColor(color.FgHiRed, " * Errors: %d\n", data.TotalErrors)
+		for _, e := range data.ErrorCounts.Top(3) {
+			printfColor(color.FgWhite, "   - %dx: %s\n", e.N, e.Err)
+		}
 		if details {
 			console.SetColor("Print", color.New(color.FgWhite))
 			printfColor(color.FgWhite, " - First Errors:\n")
@@ -355,6 +389,7 @@ func (l LiveAggregate) Report(op string, o ReportOptions) string {
 			}
 		}
 	}
+	data.reportInvalidDurations(printfColor)
 
 	if !o.SkipReqs {
 		ss, ms := mergeRequests(data.Requests)
@@ -451,6 +486,26 @@ func (r *Realtime) Report(o ReportOptions) *bytes.Buffer {
 
 	wroteOps := 0
 	allOps := stringKeysSorted(r.ByOpType)
+	if len(allOps) > 1 && !r.overLappingOps() && r.Total.TotalRequests > 0 {
+		// Show the realized mix, which may differ from the requested distribution.
+		printfColor(color.FgHiWhite, "Operation mix:")
+		for i, op := range allOps {
+			sep := ","
+			if i == 0 {
+				sep = ""
+			}
+			printfColor(color.FgWhite, "%s %s: %.1f%%", sep, op, 100*float64(r.ByOpType[op].TotalRequests)/float64(r.Total.TotalRequests))
+			if req, ok := r.RequestedMix[op]; ok {
+				printfColor(color.FgWhite, " (requested %.1f%%)", 100*req)
+			}
+		}
+		for _, op := range stringKeysSorted(r.RequestedMix) {
+			if _, ok := r.ByOpType[op]; !ok && r.RequestedMix[op] > 0 {
+				printfColor(color.FgWhite, ", %s: 0.0%% (requested %.1f%%)", op, 100*r.RequestedMix[op])
+			}
+		}
+		dst.WriteString("\n\n")
+	}
 	for _, op := range allOps {
 		if len(o.OnlyOps) > 0 {
 			if _, ok := o.OnlyOps[strings.ToUpper(op)]; !ok {
@@ -538,6 +593,12 @@ func (r *Realtime) Merge(other *Realtime) {
 	setIfEmpty(&r.WarpDate, other.WarpDate)
 	setIfEmpty(&r.WarpVersion, other.WarpVersion)
 	setIfEmpty(&r.Commandline, other.Commandline)
+	if r.Tags == nil {
+		r.Tags = other.Tags
+	}
+	if r.RequestedMix == nil {
+		r.RequestedMix = other.RequestedMix
+	}
 	if r.DataVersion == 0 {
 		r.DataVersion = other.DataVersion
 	}
@@ -607,14 +668,7 @@ func Live(ops <-chan bench.Operation, updates chan UpdateReq, clientID string, e
 	}
 	lastUpdate := time.Now()
 	for op := range ops {
-		dur := op.End.Sub(op.Start)
-		if dur < 0 {
-			op.End = op.Start
-			op.Err += "Negative duration"
-		} else {
-			// Rewrite op.End in case a non-monotonic adjustment has been made.
-			op.End = op.Start.Add(dur)
-		}
+		op.FixDuration()
 		if reset.CompareAndSwap(true, false) {
 			a = newRealTime()
 		}
@@ -0,0 +1,244 @@
+/*
+ * Warp (C) 2019-2025 MinIO, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package aggregate
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+)
+
+// SLO is a service level objective checked against the final benchmark result.
+type SLO struct {
+	// Text is the objective as specified.
+	Text string `json:"text"`
+	// Op is the upper case operation type. Empty means all operations.
+	Op string `json:"op,omitempty"`
+	// Metric is one of avg, p50, p90, p99 or error_rate.
+	Metric string `json:"metric"`
+	// Below is true if the value must be below Limit, otherwise it must be above.
+	Below bool `json:"below"`
+	// Limit in milliseconds for latencies or as a fraction for error_rate.
+	Limit float64 `json:"limit"`
+}
+
+// sloMetrics contains the supported metrics and whether they are latencies.
+var sloMetrics = map[string]bool{
+	"avg":        true,
+	"p50":        true,
+	"p90":        true,
+	"p99":        true,
+	"error_rate": false,
+}
+
+// ParseSLOs parses a comma separated list of objectives.
+// Each objective is '[op.]metric<value' or '[op.]metric>value',
+// for example 'get.p99<30ms,error_rate<0.001'.
+// Latencies must have a time unit.
+func ParseSLOs(s string) ([]SLO, error) {
+	var res []SLO
+	for text := range strings.SplitSeq(s, ",") {
+		text = strings.TrimSpace(text)
+		if text == "" {
+			continue
+		}
+		idx := strings.IndexAny(text, "<>")
+		if idx <= 0 || idx == len(text)-1 {
+			return nil, fmt.Errorf("slo %q: expected '[op.]metric<value' or '[op.]metric>value'", text)
+		}
+		slo := SLO{Text: text, Below: text[idx] == '<', Metric: strings.ToLower(text[:idx])}
+		if op, metric, ok := strings.Cut(slo.Metric, "."); ok {
+			slo.Op, slo.Metric = strings.ToUpper(op), metric
+		}
+		isLatency, ok := sloMetrics[slo.Metric]
+		if !ok {
+			return nil, fmt.Errorf("slo %q: unknown metric %q. Use avg, p50, p90, p99 or error_rate", text, slo.Metric)
+		}
+		value := strings.TrimSpace(text[idx+1:])
+		if isLatency {
+			d, err := time.ParseDuration(value)
+			if err != nil {
+				return nil, fmt.Errorf("slo %q: %w", text, err)
+			}
+			slo.Limit = float64(d) / float64(time.Millisecond)
+		} else {
+			f, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("slo %q: %w", text, err)
+			}
+			slo.Limit = f
+		}
+		res = append(res, slo)
+	}
+	return res, nil
+}
+
+// SLOResult is the outcome of checking a single SLO.
+type SLOResult struct {
+	SLO
+	// Value is the measured value. Only valid if Err is empty.
+	Value float64 `json:"value"`
+	// Passed is true if the objective was met.
+	Passed bool `json:"passed"`
+	// Err is set if the value could not be determined.
+	Err string `json:"err,omitempty"`
+}
+
+// SLOResults contains the results of all checked objectives.
+type SLOResults []SLOResult
+
+// CheckSLOs will check the objectives against the final result.
+// Objectives that cannot be evaluated are considered failed.
+func (r *Realtime) CheckSLOs(slos []SLO) SLOResults {
+	res := make(SLOResults, 0, len(slos))
+	for _, slo := range slos {
+		res = append(res, r.checkSLO(slo))
+	}
+	return res
+}
+
+func (r *Realtime) checkSLO(slo SLO) SLOResult {
+	res := SLOResult{SLO: slo}
+	data := &r.Total
+	if slo.Op != "" {
+		data = r.ByOpType[slo.Op]
+	}
+	if data == nil || data.TotalRequests == 0 {
+		res.Err = "no requests recorded"
+		return res
+	}
+	if slo.Metric == "error_rate" {
+		res.Value = float64(data.TotalErrors) / float64(data.TotalRequests)
+	} else {
+		ss, _ := mergeRequests(data.Requests)
+		if ss.MergedEntries == 0 {
+			res.Err = "request latency not available"
+			return res
+		}
+		res.Value = sloLatency(ss, slo.Metric)
+	}
+	res.Passed = slo.met(res.Value)
+	return res
+}
+
+// CheckSLOs will check the objectives against the analyzed operations.
+// Latency objectives without an operation type can only be evaluated
+// if a single operation type was benchmarked.
+// Objectives that cannot be evaluated are considered failed.
+func (a Aggregated) CheckSLOs(slos []SLO) SLOResults {
+	res := make(SLOResults, 0, len(slos))
+	for _, slo := range slos {
+		res = append(res, a.checkSLO(slo))
+	}
+	return res
+}
+
+func (a Aggregated) checkSLO(slo SLO) SLOResult {
+	res := SLOResult{SLO: slo}
+	var ops []Operation
+	var requests, errs int
+	for _, op := range a.Operations {
+		if slo.Op == "" || op.Type == slo.Op {
+			ops = append(ops, op)
+			requests += op.N
+			errs += op.Errors
+		}
+	}
+	if requests == 0 {
+		res.Err = "no requests recorded"
+		return res
+	}
+	if slo.Metric == "error_rate" {
+		res.Value = float64(errs) / float64(requests)
+	} else {
+		if len(ops) > 1 {
+			res.Err = "request latency of mixed operations not available, specify an operation"
+			return res
+		}
+		ss := ops[0].SingleSizedRequests
+		if ss == nil || ss.Skipped || ss.MergedEntries == 0 {
+			res.Err = "request latency not available"
+			return res
+		}
+		res.Value = sloLatency(*ss, slo.Metric)
+	}
+	res.Passed = slo.met(res.Value)
+	return res
+}
+
+// sloLatency returns the latency metric in milliseconds.
+func sloLatency(ss SingleSizedRequests, metric string) float64 {
+	sum := ss.DurAvgMillis
+	switch metric {
+	case "p50":
+		sum = ss.DurMedianMillis
+	case "p90":
+		sum = ss.Dur90Millis
+	case "p99":
+		sum = ss.Dur99Millis
+	}
+	return sum / float64(ss.MergedEntries)
+}
+
+// met returns whether value meets the objective.
+func (s SLO) met(value float64) bool {
+	if s.Below {
+		return value < s.Limit
+	}
+	return value > s.Limit
+}
+
+// Failed returns the number of objectives that were not met.
+func (s SLOResults) Failed() int {
+	n := 0
+	for _, r := range s {
+		if !r.Passed {
+			n++
+		}
+	}
+	return n
+}
+
+// Report returns a human readable report of the results.
+func (s SLOResults) Report(o ReportOptions) string {
+	dst := bytes.NewBuffer(make([]byte, 0, 256))
+	printfColor := o.printfColor(dst)
+	printfColor(color.FgHiWhite, "SLO checks: %d of %d passed\n", len(s)-s.Failed(), len(s))
+	for _, r := range s {
+		var value string
+		switch {
+		case r.Err != "":
+			value = r.Err
+		case sloMetrics[r.Metric]:
+			value = fmt.Sprintf("%.2fms", r.Value)
+		default:
+			value = strconv.FormatFloat(r.Value, 'g', 4, 64)
+		}
+		if r.Passed {
+			printfColor(color.FgHiGreen, " * PASS")
+		} else {
+			printfColor(color.FgHiRed, " * FAIL")
+		}
+		printfColor(color.FgWhite, " %s (measured: %s)\n", r.Text, value)
+	}
+	return dst.String()
+}
@@ -0,0 +1,185 @@
+/*
+ * Warp (C) 2019-2025 MinIO, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package aggregate
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseSLOs(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    []SLO
+		wantErr bool
+	}{
+		{in: "", want: nil},
+		{
+			in:   "get.p99<30ms",
+			want: []SLO{{Text: "get.p99<30ms", Op: "GET", Metric: "p99", Below: true, Limit: 30}},
+		},
+		{
+			in:   "avg>1.5s",
+			want: []SLO{{Text: "avg>1.5s", Metric: "avg", Below: false, Limit: 1500}},
+		},
+		{
+			in: " PUT.P50<500us , error_rate<0.001,",
+			want: []SLO{
+				{Text: "PUT.P50<500us", Op: "PUT", Metric: "p50", Below: true, Limit: 0.5},
+				{Text: "error_rate<0.001", Metric: "error_rate", Below: true, Limit: 0.001},
+			},
+		},
+		{in: "p99<30", wantErr: true},
+		{in: "p99<fast", wantErr: true},
+		{in: "error_rate<1%", wantErr: true},
+		{in: "p95<30ms", wantErr: true},
+		{in: "get.p99", wantErr: true},
+		{in: "<30ms", wantErr: true},
+		{in: "p99<", wantErr: true},
+		{in: "get.p99<30ms,p99<", wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.in, func(t *testing.T) {
+			got, err := ParseSLOs(test.in)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("got error %v, want error: %v", err, test.wantErr)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestRealtime_CheckSLOs(t *testing.T) {
+	get := LiveAggregate{
+		TotalRequests: 1000,
+		TotalErrors:   2,
+		Requests: map[string]RequestSegments{
+			"client": {
+				{Single: &SingleSizedRequests{MergedEntries: 1, DurAvgMillis: 10, DurMedianMillis: 8, Dur90Millis: 15, Dur99Millis: 20}},
+				{Single: &SingleSizedRequests{MergedEntries: 1, DurAvgMillis: 20, DurMedianMillis: 18, Dur90Millis: 25, Dur99Millis: 40}},
+			},
+		},
+	}
+	put := LiveAggregate{
+		TotalRequests: 100,
+		Requests: map[string]RequestSegments{
+			"client": {{Multi: &MultiSizedRequests{}}},
+		},
+	}
+	rt := Realtime{
+		Total:    get,
+		ByOpType: map[string]*LiveAggregate{"GET": &get, "PUT": &put},
+	}
+
+	tests := []struct {
+		slo    string
+		value  float64
+		passed bool
+		err    bool
+	}{
+		{slo: "get.p99<31ms", value: 30, passed: true},
+		{slo: "get.p99<30ms", value: 30, passed: false},
+		{slo: "get.p99>29ms", value: 30, passed: true},
+		{slo: "avg<20ms", value: 15, passed: true},
+		{slo: "p50<10ms", value: 13, passed: false},
+		{slo: "get.p90<20ms", value: 20, passed: false},
+		{slo: "error_rate<0.003", value: 0.002, passed: true},
+		{slo: "get.error_rate<0.001", value: 0.002, passed: false},
+		{slo: "put.error_rate<0.001", value: 0, passed: true},
+		{slo: "put.p99<30ms", passed: false, err: true},
+		{slo: "delete.p99<30ms", passed: false, err: true},
+		{slo: "delete.error_rate<0.001", passed: false, err: true},
+	}
+	for _, test := range tests {
+		t.Run(test.slo, func(t *testing.T) {
+			slos, err := ParseSLOs(test.slo)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res := rt.CheckSLOs(slos)
+			if len(res) != 1 {
+				t.Fatalf("got %d results, want 1", len(res))
+			}
+			got := res[0]
+			if (got.Err != "") != test.err {
+				t.Fatalf("got error %q, want error: %v", got.Err, test.err)
+			}
+			if got.Passed != test.passed {
+				t.Errorf("got passed %v, want %v", got.Passed, test.passed)
+			}
+			if !test.err && got.Value != test.value {
+				t.Errorf("got value %v, want %v", got.Value, test.value)
+			}
+			wantFailed := 0
+			if !test.passed {
+				wantFailed = 1
+			}
+			if res.Failed() != wantFailed {
+				t.Errorf("got %d failed, want %d", res.Failed(), wantFailed)
+			}
+		})
+	}
+}
+
+func TestAggregated_CheckSLOs(t *testing.T) {
+	single := Aggregated{Operations: []Operation{
+		{Type: "GET", N: 1000, Errors: 2, SingleSizedRequests: &SingleSizedRequests{MergedEntries: 1, DurAvgMillis: 15, Dur99Millis: 30}},
+	}}
+	mixed := Aggregated{Mixed: true, Operations: []Operation{
+		{Type: "GET", N: 1000, Errors: 2, SingleSizedRequests: &SingleSizedRequests{MergedEntries: 1, Dur99Millis: 30}},
+		{Type: "PUT", N: 1000, MultiSizedRequests: &MultiSizedRequests{}},
+	}}
+
+	tests := []struct {
+		aggr   Aggregated
+		slo    string
+		value  float64
+		passed bool
+		err    bool
+	}{
+		{aggr: single, slo: "get.p99<31ms", value: 30, passed: true},
+		{aggr: single, slo: "p99<30ms", value: 30, passed: false},
+		{aggr: single, slo: "avg<20ms", value: 15, passed: true},
+		{aggr: single, slo: "error_rate<0.003", value: 0.002, passed: true},
+		{aggr: single, slo: "put.error_rate<0.003", err: true},
+		{aggr: mixed, slo: "get.p99<31ms", value: 30, passed: true},
+		{aggr: mixed, slo: "error_rate<0.001", value: 0.001, passed: false},
+		{aggr: mixed, slo: "p99<30ms", err: true},
+		{aggr: mixed, slo: "put.p99<30ms", err: true},
+	}
+	for _, test := range tests {
+		t.Run(test.slo, func(t *testing.T) {
+			slos, err := ParseSLOs(test.slo)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := test.aggr.CheckSLOs(slos)[0]
+			if (got.Err != "") != test.err {
+				t.Fatalf("got error %q, want error: %v", got.Err, test.err)
+			}
+			if got.Passed != test.passed {
+				t.Errorf("got passed %v, want %v", got.Passed, test.passed)
+			}
+			if !test.err && got.Value != test.value {
+				t.Errorf("got value %v, want %v", got.Value, test.value)
+			}
+		})
+	}
+}
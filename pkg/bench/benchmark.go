@@ -108,6 +108,9 @@ type Common struct {
 	// ratelimiting
 	RpsLimiter *rate.Limiter
 
+	// Pauser can pause workers while running. May be nil.
+	Pauser *Pauser
+
 	// Transport used.
 	Transport http.RoundTripper
 
@@ -264,7 +267,11 @@ func (c *Common) prepareProgress(progress float64) {
 	}
 }
 
+// rpsLimit blocks while paused and until the rate limiter allows another request.
 func (c *Common) rpsLimit(ctx context.Context) error {
+	if err := c.Pauser.Wait(ctx); err != nil {
+		return err
+	}
 	if c.RpsLimiter == nil {
 		return nil
 	}
@@ -68,6 +68,7 @@ func NewOpsCollector(extra ...chan<- Operation) (Collector, OpsCollector) {
 	go func() {
 		defer r.rcvWg.Done()
 		for op := range r.rcv {
+			op.FixDuration()
 			for _, ch := range extra {
 				ch <- op
 			}
@@ -73,6 +73,11 @@ func (u *Fanout) Start(ctx context.Context, wait chan struct{}) error {
 					return
 				default:
 				}
+
+				if u.rpsLimit(ctx) != nil {
+					return
+				}
+
 				obj := src.Object()
 				for i := range opts.Entries {
 					opts.Entries[i] = minio.PutObjectFanOutEntry{
@@ -54,6 +54,31 @@ func (o Operation) Duration() time.Duration {
 	return o.End.Sub(o.Start)
 }
 
+// ErrInvalidDuration is set on operations that did not have a positive duration.
+const ErrInvalidDuration = "invalid duration: end time not after start time"
+
+// FixDuration rewrites End, so it no longer depends on the wall clock.
+// If the duration is zero or negative, End is set to Start and the operation
+// is marked as failed, unless it already failed.
+// Use InvalidDuration to detect these operations afterward.
+func (o *Operation) FixDuration() {
+	dur := o.End.Sub(o.Start)
+	if dur <= 0 {
+		o.End = o.Start
+		if o.Err == "" {
+			o.Err = ErrInvalidDuration
+		}
+		return
+	}
+	// Rewrite End in case a non-monotonic adjustment has been made.
+	o.End = o.Start.Add(dur)
+}
+
+// InvalidDuration returns true if the operation does not have a positive duration.
+func (o Operation) InvalidDuration() bool {
+	return !o.End.After(o.Start)
+}
+
 // Throughput is the throughput as bytes/second.
 type Throughput float64
 
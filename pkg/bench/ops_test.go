@@ -0,0 +1,56 @@
+/*
+ * Warp (C) 2019-2025 MinIO, Inc.
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package bench
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOperation_FixDuration(t *testing.T) {
+	start := time.Now()
+	tests := []struct {
+		name    string
+		dur     time.Duration
+		err     string
+		wantDur time.Duration
+		wantErr string
+		invalid bool
+	}{
+		{name: "positive", dur: time.Second, wantDur: time.Second},
+		{name: "positive-failed", dur: time.Second, err: "access denied", wantDur: time.Second, wantErr: "access denied"},
+		{name: "zero", dur: 0, wantErr: ErrInvalidDuration, invalid: true},
+		{name: "negative", dur: -time.Second, wantErr: ErrInvalidDuration, invalid: true},
+		{name: "negative-failed", dur: -time.Second, err: "access denied", wantErr: "access denied", invalid: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			op := Operation{Start: start, End: start.Add(test.dur), Err: test.err}
+			op.FixDuration()
+			if got := op.Duration(); got != test.wantDur {
+				t.Errorf("got duration %v, want %v", got, test.wantDur)
+			}
+			if op.Err != test.wantErr {
+				t.Errorf("got error %q, want %q", op.Err, test.wantErr)
+			}
+			if got := op.InvalidDuration(); got != test.invalid {
+				t.Errorf("got invalid %v, want %v", got, test.invalid)
+			}
+		})
+	}
+}